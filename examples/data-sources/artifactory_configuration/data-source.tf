@@ -0,0 +1,7 @@
+data "artifactory_configuration" "config" {
+  sections = ["mailServer", "security"]
+}
+
+output "mail_server" {
+  value = yamldecode(data.artifactory_configuration.config.yaml).mailServer
+}
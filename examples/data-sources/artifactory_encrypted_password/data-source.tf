@@ -0,0 +1,10 @@
+data "artifactory_encrypted_password" "ci" {}
+
+resource "local_sensitive_file" "npmrc" {
+  filename = "${path.module}/.npmrc"
+  content  = <<EOT
+registry=https://myinstance.jfrog.io/artifactory/api/npm/npm-virtual/
+_auth=${base64encode("ci-user:${data.artifactory_encrypted_password.ci.encrypted_password}")}
+always-auth=true
+EOT
+}
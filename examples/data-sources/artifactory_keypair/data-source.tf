@@ -0,0 +1,8 @@
+data "artifactory_keypair" "rsa" {
+  pair_name = "my-rsa-keypair"
+}
+
+resource "artifactory_local_alpine_repository" "alpine-local" {
+  key                 = "alpine-local"
+  primary_keypair_ref = data.artifactory_keypair.rsa.pair_name
+}
@@ -0,0 +1,3 @@
+data "artifactory_remote_repository_status" "npm-remote" {
+  key = "npm-remote"
+}
@@ -5,11 +5,7 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	provider "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/provider"
 )
 
@@ -25,22 +21,7 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	upgradedSdkServer, err := tf5to6server.UpgradeServer(
-		ctx,
-		provider.SdkV2().GRPCProvider, // terraform-plugin-sdk provider
-	)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	providers := []func() tfprotov6.ProviderServer{
-		providerserver.NewProtocol6(provider.Framework()()), // terraform-plugin-framework provider
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkServer
-		},
-	}
-
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	providerServerFactory, err := provider.ProtoV6ProviderServerFactory(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -53,7 +34,7 @@ func main() {
 
 	err = tf6server.Serve(
 		"jfrog/artifactory",
-		muxServer.ProviderServer,
+		providerServerFactory,
 		serveOpts...,
 	)
 
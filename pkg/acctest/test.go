@@ -13,8 +13,6 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -59,30 +57,12 @@ func init() {
 
 	ProtoV6MuxProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"artifactory": func() (tfprotov6.ProviderServer, error) {
-			ctx := context.Background()
-
-			upgradedSdkServer, err := tf5to6server.UpgradeServer(
-				ctx,
-				provider.SdkV2().GRPCProvider, // terraform-plugin-sdk provider
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			providers := []func() tfprotov6.ProviderServer{
-				providerserver.NewProtocol6(provider.Framework()()), // terraform-plugin-framework provider
-				func() tfprotov6.ProviderServer {
-					return upgradedSdkServer
-				},
-			}
-
-			muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
-
+			providerServerFactory, err := provider.ProtoV6ProviderServerFactory(context.Background())
 			if err != nil {
 				return nil, err
 			}
 
-			return muxServer.ProviderServer(), nil
+			return providerServerFactory(), nil
 		},
 	}
 }
@@ -0,0 +1,304 @@
+package configuration
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+var _ datasource.DataSource = &ConfigurationDataSource{}
+
+func NewConfigurationDataSource() datasource.DataSource {
+	return &ConfigurationDataSource{}
+}
+
+type ConfigurationDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type ConfigurationDataSourceModel struct {
+	Sections          types.Set    `tfsdk:"sections"`
+	AvailableSections types.Set    `tfsdk:"available_sections"`
+	Yaml              types.String `tfsdk:"yaml"`
+}
+
+// sensitiveKeySubstrings are the (lower case) substrings of the configuration element names with sensitive values,
+// which are removed from the data source output. Element names ending with "token" are also removed.
+var sensitiveKeySubstrings = []string{"password", "secret", "passphrase", "privatekey", "apikey"}
+
+// nonSensitiveElementNames are the names of the configuration elements matching sensitiveKeySubstrings,
+// which hold settings rather than secrets, and are kept in the data source output.
+var nonSensitiveElementNames = map[string]bool{
+	"passwordMaxAge": true,
+}
+
+func isSensitiveKey(key string) bool {
+	if nonSensitiveElementNames[key] {
+		return false
+	}
+
+	k := strings.ToLower(key)
+	if strings.HasSuffix(k, "token") {
+		return true
+	}
+
+	for _, s := range sensitiveKeySubstrings {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listElementNames are the names of the configuration elements, which may occur multiple times within their
+// parent element. They are always represented as lists, even with a single occurrence, so the same setting has
+// the same shape on every instance.
+var listElementNames = map[string]bool{
+	"repositoryRef":     true,
+	"propertySetRef":    true,
+	"propertySet":       true,
+	"property":          true,
+	"predefinedValue":   true,
+	"repoLayout":        true,
+	"ldapGroupSetting":  true,
+	"localReplication":  true,
+	"remoteReplication": true,
+	"member":            true,
+}
+
+type xmlNode struct {
+	XMLName  xml.Name
+	Content  string    `xml:",chardata"`
+	Children []xmlNode `xml:",any"`
+}
+
+// isKeyedCollection returns true if all the children share the same element name and have a 'key' element,
+// e.g. 'localRepositories', 'proxies', or 'backups'. Such collections are represented as maps keyed by the
+// 'key' value, the same way as in the Artifactory YAML configuration.
+func isKeyedCollection(children []xmlNode) bool {
+	if len(children) == 0 {
+		return false
+	}
+
+	for _, child := range children {
+		if child.XMLName.Local != children[0].XMLName.Local || keyOf(child) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func keyOf(node xmlNode) string {
+	for _, child := range node.Children {
+		if child.XMLName.Local == "key" && len(child.Children) == 0 {
+			return strings.TrimSpace(child.Content)
+		}
+	}
+	return ""
+}
+
+// toValue converts the XML element into a value, which can be marshalled into YAML.
+// Leaf elements with sensitive values are dropped, and elements in listElementNames are always lists.
+func toValue(node xmlNode) interface{} {
+	if len(node.Children) == 0 {
+		return strings.TrimSpace(node.Content)
+	}
+
+	if isKeyedCollection(node.Children) {
+		collection := map[string]interface{}{}
+		for _, child := range node.Children {
+			value := toValue(child)
+			if m, ok := value.(map[string]interface{}); ok {
+				delete(m, "key")
+			}
+			collection[keyOf(child)] = value
+		}
+		return collection
+	}
+
+	counts := map[string]int{}
+	for _, child := range node.Children {
+		counts[child.XMLName.Local]++
+	}
+
+	// Unknown repeated elements are also represented as lists, so no value is lost
+	value := map[string]interface{}{}
+	for _, child := range node.Children {
+		name := child.XMLName.Local
+		if len(child.Children) == 0 && isSensitiveKey(name) {
+			continue
+		}
+
+		if listElementNames[name] || counts[name] > 1 {
+			list, _ := value[name].([]interface{})
+			value[name] = append(list, toValue(child))
+			continue
+		}
+
+		value[name] = toValue(child)
+	}
+
+	return value
+}
+
+// DescriptorToMap converts the XML configuration descriptor into a sanitized map of the top level sections,
+// which can be marshalled into YAML.
+func DescriptorToMap(descriptor []byte) (map[string]interface{}, error) {
+	var root xmlNode
+	if err := xml.Unmarshal(descriptor, &root); err != nil {
+		return nil, err
+	}
+
+	config, ok := toValue(root).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("configuration descriptor has no sections")
+	}
+
+	return config, nil
+}
+
+func (d *ConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration"
+}
+
+func (d *ConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"sections": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Top level sections of the configuration to include, e.g. `localRepositories`, `proxies`, or `mailServer`. If not set, the whole configuration is returned.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"available_sections": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "All top level sections of the configuration.",
+				Computed:    true,
+			},
+			"yaml": schema.StringAttribute{
+				Description: "Global configuration descriptor in YAML format. Sensitive values, e.g. passwords, secrets, private keys, and tokens, are removed.",
+				Computed:    true,
+			},
+		},
+		Description: "Returns the sanitized global configuration descriptor in YAML format, optionally filtered by section. " +
+			"Requires admin privileges.",
+	}
+}
+
+func (d *ConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConfigurationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sections []string
+	resp.Diagnostics.Append(data.Sections.ElementsAs(ctx, &sections, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.ProviderData.Client.R().
+		Get(configuration.ConfigurationEndpoint)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	config, err := DescriptorToMap(response.Body())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while parsing the configuration descriptor. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	availableSections := lo.Keys(config)
+	sort.Strings(availableSections)
+
+	if len(sections) > 0 {
+		filtered := map[string]interface{}{}
+		for _, section := range sections {
+			value, ok := config[section]
+			if !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("sections"),
+					"Invalid Section",
+					fmt.Sprintf("Section '%s' not found in configuration. Available sections: %s", section, strings.Join(availableSections, ", ")),
+				)
+				return
+			}
+			filtered[section] = value
+		}
+		config = filtered
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while converting the configuration to YAML. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	availableSectionsSet, ds := types.SetValueFrom(ctx, types.StringType, availableSections)
+	if ds.HasError() {
+		resp.Diagnostics.Append(ds...)
+		return
+	}
+
+	data.AvailableSections = availableSectionsSet
+	data.Yaml = types.StringValue(string(content))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,195 @@
+package configuration_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/datasource/configuration"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestDescriptorToMap(t *testing.T) {
+	descriptor := []byte(`
+		<config xmlns="http://artifactory.jfrog.org/xsd/3.1.2">
+			<mailServer>
+				<enabled>true</enabled>
+				<host>smtp.example.com</host>
+				<username>admin</username>
+				<password>secret</password>
+			</mailServer>
+			<security>
+				<ldapSettings>
+					<ldapSetting>
+						<key>ldap1</key>
+						<ldapUrl>ldap://ldap.example.com</ldapUrl>
+						<managerPassword>secret</managerPassword>
+					</ldapSetting>
+					<ldapSetting>
+						<key>ldap2</key>
+						<ldapUrl>ldap://ldap2.example.com</ldapUrl>
+					</ldapSetting>
+				</ldapSettings>
+				<accessToken>secret</accessToken>
+				<trustStorePassword>secret</trustStorePassword>
+				<bearerToken>secret</bearerToken>
+				<passwordSettings>
+					<expirationPolicy>
+						<enabled>true</enabled>
+						<passwordMaxAge>60</passwordMaxAge>
+						<notifyByEmail>true</notifyByEmail>
+					</expirationPolicy>
+				</passwordSettings>
+			</security>
+			<indexer>
+				<includedRepositories>
+					<repositoryRef>libs-release-local</repositoryRef>
+					<repositoryRef>libs-snapshot-local</repositoryRef>
+				</includedRepositories>
+				<excludedRepositories>
+					<repositoryRef>libs-remote</repositoryRef>
+				</excludedRepositories>
+			</indexer>
+			<propertySets>
+				<propertySet>
+					<name>build</name>
+					<visible>true</visible>
+				</propertySet>
+			</propertySets>
+		</config>
+	`)
+
+	config, err := configuration.DescriptorToMap(descriptor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mailServer := config["mailServer"].(map[string]interface{})
+	if mailServer["host"] != "smtp.example.com" {
+		t.Errorf("expected mailServer.host to be 'smtp.example.com', got %v", mailServer["host"])
+	}
+	if _, ok := mailServer["password"]; ok {
+		t.Error("expected mailServer.password to be removed")
+	}
+
+	security := config["security"].(map[string]interface{})
+	if _, ok := security["accessToken"]; ok {
+		t.Error("expected security.accessToken to be removed")
+	}
+
+	for _, key := range []string{"trustStorePassword", "bearerToken"} {
+		if _, ok := security[key]; ok {
+			t.Errorf("expected security.%s to be removed", key)
+		}
+	}
+
+	expirationPolicy := security["passwordSettings"].(map[string]interface{})["expirationPolicy"].(map[string]interface{})
+	if expirationPolicy["passwordMaxAge"] != "60" {
+		t.Errorf("expected non-secret passwordMaxAge to be kept, got %v", expirationPolicy["passwordMaxAge"])
+	}
+
+	ldapSettings := security["ldapSettings"].(map[string]interface{})
+	if len(ldapSettings) != 2 {
+		t.Fatalf("expected 2 LDAP settings keyed by 'key', got %v", ldapSettings)
+	}
+	ldap1 := ldapSettings["ldap1"].(map[string]interface{})
+	if ldap1["ldapUrl"] != "ldap://ldap.example.com" {
+		t.Errorf("expected ldap1.ldapUrl to be 'ldap://ldap.example.com', got %v", ldap1["ldapUrl"])
+	}
+	if _, ok := ldap1["managerPassword"]; ok {
+		t.Error("expected ldap1.managerPassword to be removed")
+	}
+	if _, ok := ldap1["key"]; ok {
+		t.Error("expected ldap1.key to be removed")
+	}
+
+	indexer := config["indexer"].(map[string]interface{})
+	includedRepositories := indexer["includedRepositories"].(map[string]interface{})
+	repositoryRefs := includedRepositories["repositoryRef"].([]interface{})
+	if len(repositoryRefs) != 2 {
+		t.Errorf("expected 2 repository references, got %v", repositoryRefs)
+	}
+
+	excludedRepositories := indexer["excludedRepositories"].(map[string]interface{})
+	excludedRepositoryRefs, ok := excludedRepositories["repositoryRef"].([]interface{})
+	if !ok || len(excludedRepositoryRefs) != 1 || excludedRepositoryRefs[0] != "libs-remote" {
+		t.Errorf("expected single repository reference to be a list, got %v", excludedRepositories["repositoryRef"])
+	}
+
+	propertySets := config["propertySets"].(map[string]interface{})
+	propertySetList, ok := propertySets["propertySet"].([]interface{})
+	if !ok || len(propertySetList) != 1 {
+		t.Errorf("expected single property set to be a list, got %v", propertySets["propertySet"])
+	}
+}
+
+func TestDescriptorToMap_invalid_descriptor(t *testing.T) {
+	if _, err := configuration.DescriptorToMap([]byte("not xml")); err == nil {
+		t.Error("expected error for invalid descriptor")
+	}
+}
+
+var sensitiveKeyRegex = regexp.MustCompile(`(?m)^\s*(password|managerPassword|secret|privateKey|passphrase|apiKey|accessToken):`)
+
+func TestAccDataSourceConfiguration(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("config", "data.artifactory_configuration")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceConfiguration", `
+		data "artifactory_configuration" "{{ .name }}" {
+		  sections = ["mailServer", "security"]
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "sections.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "available_sections.*", "security"),
+					resource.TestMatchResourceAttr(fqrn, "yaml", regexp.MustCompile(`(?m)^security:`)),
+					func(s *terraform.State) error {
+						yaml := s.RootModule().Resources[fqrn].Primary.Attributes["yaml"]
+						if sensitiveKeyRegex.MatchString(yaml) {
+							return fmt.Errorf("expected no sensitive keys in yaml, got: %s", sensitiveKeyRegex.FindString(yaml))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceConfiguration_invalid_section(t *testing.T) {
+	_, _, name := testutil.MkNames("config", "data.artifactory_configuration")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceConfiguration", `
+		data "artifactory_configuration" "{{ .name }}" {
+		  sections = ["nonExistingSection"]
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*Section 'nonExistingSection' not found in configuration.*"),
+			},
+		},
+	})
+}
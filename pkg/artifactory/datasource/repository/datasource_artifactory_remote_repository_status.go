@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const RemoteRepositoryStatusEndpoint = "artifactory/api/repositories/{key}/status"
+
+var _ datasource.DataSource = &RemoteRepositoryStatusDataSource{}
+
+func NewRemoteRepositoryStatusDataSource() datasource.DataSource {
+	return &RemoteRepositoryStatusDataSource{}
+}
+
+type RemoteRepositoryStatusDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type RemoteRepositoryStatusDataSourceModel struct {
+	Key            types.String `tfsdk:"key"`
+	Offline        types.Bool   `tfsdk:"offline"`
+	Online         types.Bool   `tfsdk:"online"`
+	AssumedOffline types.Bool   `tfsdk:"assumed_offline"`
+	Status         types.String `tfsdk:"status"`
+}
+
+type remoteRepositoryConfigAPIModel struct {
+	Key     string `json:"key"`
+	Rclass  string `json:"rclass"`
+	Offline bool   `json:"offline"`
+}
+
+type RemoteRepositoryStatusAPIModel struct {
+	Status         string `json:"status"`
+	Online         bool   `json:"online"`
+	AssumedOffline bool   `json:"assumedOffline"`
+}
+
+func (m *RemoteRepositoryStatusDataSourceModel) FromAPIModel(ctx context.Context, config remoteRepositoryConfigAPIModel, status RemoteRepositoryStatusAPIModel) {
+	m.Offline = types.BoolValue(config.Offline)
+	m.Online = types.BoolValue(status.Online)
+	m.AssumedOffline = types.BoolValue(status.AssumedOffline)
+	m.Status = types.StringValue(status.Status)
+}
+
+func (d *RemoteRepositoryStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_repository_status"
+}
+
+func (d *RemoteRepositoryStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "Key of the remote repository.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"offline": schema.BoolAttribute{
+				Description: "`true` if the repository has been manually set offline by an administrator.",
+				Computed:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "`true` if the upstream URL of the repository is reachable.",
+				Computed:    true,
+			},
+			"assumed_offline": schema.BoolAttribute{
+				Description: "`true` if the repository is currently in assumed offline state after a connection error. The state is reset after `assumed_offline_period_secs` has elapsed and an online check succeeds.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the repository as reported by Artifactory, e.g. `ONLINE`, `OFFLINE`, or `UNAVAILABLE`.",
+				Computed:    true,
+			},
+		},
+		Description: "Returns the upstream health of a remote repository, i.e. whether the remote URL is reachable and whether the repository is in assumed offline state.",
+	}
+}
+
+func (d *RemoteRepositoryStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *RemoteRepositoryStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RemoteRepositoryStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config remoteRepositoryConfigAPIModel
+	response, err := d.ProviderData.Client.R().
+		SetPathParam("key", data.Key.ValueString()).
+		SetResult(&config).
+		Get(repository.RepositoriesEndpoint)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	if config.Rclass != "remote" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key"),
+			"Invalid Repository Type",
+			fmt.Sprintf("Repository '%s' is a %s repository. Only remote repositories are supported.", data.Key.ValueString(), config.Rclass),
+		)
+		return
+	}
+
+	var status RemoteRepositoryStatusAPIModel
+	response, err = d.ProviderData.Client.R().
+		SetPathParam("key", data.Key.ValueString()).
+		SetResult(&status).
+		Get(RemoteRepositoryStatusEndpoint)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	data.FromAPIModel(ctx, config, status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceRemoteRepositoryStatus(t *testing.T) {
+	_, _, repoName := testutil.MkNames("generic-remote", "artifactory_remote_generic_repository")
+	_, fqrn, name := testutil.MkNames("status", "data.artifactory_remote_repository_status")
+
+	params := map[string]interface{}{
+		"repoName": repoName,
+		"name":     name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceRemoteRepositoryStatus", `
+		resource "artifactory_remote_generic_repository" "{{ .repoName }}" {
+		  key     = "{{ .repoName }}"
+		  url     = "https://tempurl.org"
+		  offline = true
+		}
+
+		data "artifactory_remote_repository_status" "{{ .name }}" {
+		  key = artifactory_remote_generic_repository.{{ .repoName }}.key
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", repoName),
+					resource.TestCheckResourceAttr(fqrn, "offline", "true"),
+					resource.TestCheckResourceAttr(fqrn, "online", "false"),
+					resource.TestCheckResourceAttr(fqrn, "assumed_offline", "false"),
+					resource.TestCheckResourceAttr(fqrn, "status", "OFFLINE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRemoteRepositoryStatus_unreachable_url(t *testing.T) {
+	_, _, repoName := testutil.MkNames("generic-remote", "artifactory_remote_generic_repository")
+	_, fqrn, name := testutil.MkNames("status", "data.artifactory_remote_repository_status")
+
+	params := map[string]interface{}{
+		"repoName": repoName,
+		"name":     name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceRemoteRepositoryStatus", `
+		resource "artifactory_remote_generic_repository" "{{ .repoName }}" {
+		  key = "{{ .repoName }}"
+		  url = "https://unreachable.invalid"
+		}
+
+		data "artifactory_remote_repository_status" "{{ .name }}" {
+		  key = artifactory_remote_generic_repository.{{ .repoName }}.key
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", repoName),
+					resource.TestCheckResourceAttr(fqrn, "offline", "false"),
+					resource.TestCheckResourceAttr(fqrn, "online", "false"),
+					resource.TestCheckResourceAttr(fqrn, "status", "UNAVAILABLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRemoteRepositoryStatus_non_remote_repository(t *testing.T) {
+	_, _, repoName := testutil.MkNames("generic-local", "artifactory_local_generic_repository")
+	_, _, name := testutil.MkNames("status", "data.artifactory_remote_repository_status")
+
+	params := map[string]interface{}{
+		"repoName": repoName,
+		"name":     name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceRemoteRepositoryStatus", `
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+		  key = "{{ .repoName }}"
+		}
+
+		data "artifactory_remote_repository_status" "{{ .name }}" {
+		  key = artifactory_local_generic_repository.{{ .repoName }}.key
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*Only remote repositories are supported.*"),
+			},
+		},
+	})
+}
@@ -17,10 +17,12 @@ func DatasourceArtifactoryVirtualGoRepository() *schema.Resource {
 			return nil, err
 		}
 
-		return &virtual.RepositoryBaseParams{
-			PackageType:   virtual.GoPackageType,
-			Rclass:        rclass,
-			RepoLayoutRef: repoLayout.(string),
+		return &virtual.GoVirtualRepositoryParams{
+			RepositoryBaseParams: virtual.RepositoryBaseParams{
+				PackageType:   virtual.GoPackageType,
+				Rclass:        rclass,
+				RepoLayoutRef: repoLayout.(string),
+			},
 		}, nil
 	}
 
@@ -0,0 +1,90 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const EncryptedPasswordEndpoint = "artifactory/api/security/encryptedPassword"
+
+var _ datasource.DataSource = &EncryptedPasswordDataSource{}
+
+func NewEncryptedPasswordDataSource() datasource.DataSource {
+	return &EncryptedPasswordDataSource{}
+}
+
+type EncryptedPasswordDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type EncryptedPasswordDataSourceModel struct {
+	EncryptedPassword types.String `tfsdk:"encrypted_password"`
+}
+
+func (d *EncryptedPasswordDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_encrypted_password"
+}
+
+func (d *EncryptedPasswordDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"encrypted_password": schema.StringAttribute{
+				Description: "Encrypted password of the user, which can be used instead of the plain text password in client configuration files, e.g. `settings.xml` or `.npmrc`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Description: "Returns the encrypted password of the user authenticated by the provider. " +
+			"Encrypted passwords can be embedded in client configuration files instead of the plain text password.",
+	}
+}
+
+func (d *EncryptedPasswordDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *EncryptedPasswordDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EncryptedPasswordDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.ProviderData.Client.R().
+		SetHeader("Accept", "text/plain").
+		Get(EncryptedPasswordEndpoint)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	// API returns the encrypted password as plain text
+	data.EncryptedPassword = types.StringValue(response.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
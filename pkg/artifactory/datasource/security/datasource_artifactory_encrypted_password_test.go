@@ -0,0 +1,34 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceEncryptedPassword(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("encrypted-password", "data.artifactory_encrypted_password")
+
+	params := map[string]string{
+		"name": name,
+	}
+	config := util.ExecuteTemplate("TestAccDataSourceEncryptedPassword", `
+	data "artifactory_encrypted_password" "{{ .name }}" {}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "encrypted_password"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var _ datasource.DataSource = &KeyPairDataSource{}
+
+func NewKeyPairDataSource() datasource.DataSource {
+	return &KeyPairDataSource{}
+}
+
+type KeyPairDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+// KeyPairDataSourceModel intentionally has no private key or passphrase attributes,
+// so they are never stored in the Terraform state of the consumers.
+type KeyPairDataSourceModel struct {
+	PairName  types.String `tfsdk:"pair_name"`
+	PairType  types.String `tfsdk:"pair_type"`
+	Alias     types.String `tfsdk:"alias"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+func (m *KeyPairDataSourceModel) FromAPIModel(ctx context.Context, model security.KeyPairAPIModel) {
+	m.PairType = types.StringValue(model.PairType)
+	m.Alias = types.StringValue(model.Alias)
+	m.PublicKey = types.StringValue(model.PublicKey)
+}
+
+func (d *KeyPairDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypair"
+}
+
+func (d *KeyPairDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"pair_name": schema.StringAttribute{
+				Description: "A unique identifier for the Key Pair record.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"pair_type": schema.StringAttribute{
+				Description: "Key Pair type. Either `GPG` or `RSA`.",
+				Computed:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "Filename used when retrieving the public key via REST API.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Public key in PEM format.",
+				Computed:    true,
+			},
+		},
+		Description: "Returns the public details of a key pair, e.g. to reference a signing key managed in another configuration. " +
+			"The private key and passphrase are never returned.",
+	}
+}
+
+func (d *KeyPairDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *KeyPairDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KeyPairDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keyPair security.KeyPairAPIModel
+	response, err := d.ProviderData.Client.R().
+		SetResult(&keyPair).
+		Get(security.KeypairEndPoint + data.PairName.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pair_name"),
+			"Key Pair Not Found",
+			fmt.Sprintf("Key pair '%s' does not exist.", data.PairName.ValueString()),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetch the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	data.FromAPIModel(ctx, keyPair)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// providerConfiguration holds the provider configuration block values, independently of the
+// SDKv2 or Framework representation of the provider schema.
+type providerConfiguration struct {
+	url              string
+	accessToken      string
+	apiKey           string
+	oidcProviderName string
+	checkLicense     bool
+}
+
+// withEnvVars fills in the values from the environment variables, if the
+// corresponding attributes are not set in the configuration block.
+func (c providerConfiguration) withEnvVars() (providerConfiguration, string) {
+	// Check environment variables, first available OS variable will be assigned to the var
+	if c.url == "" {
+		c.url = util.CheckEnvVars([]string{"JFROG_URL", "ARTIFACTORY_URL"}, "")
+	}
+	envAccessToken := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN", "ARTIFACTORY_ACCESS_TOKEN"}, "")
+
+	return c, envAccessToken
+}
+
+// configurer is the single Configure path for both SDKv2 and Framework providers.
+// When both providers are muxed, they share the same configurer so the client is built, the OIDC
+// token is exchanged, and the license is checked only once per configuration.
+type configurer struct {
+	mu             sync.Mutex
+	config         *providerConfiguration
+	envAccessToken string
+	meta           util.ProviderMetadata
+	diags          diag.Diagnostics
+}
+
+func (c *configurer) configure(ctx context.Context, config providerConfiguration, terraformVersion string) (util.ProviderMetadata, diag.Diagnostics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config, envAccessToken := config.withEnvVars()
+	if c.config != nil && *c.config == config && c.envAccessToken == envAccessToken {
+		return c.meta, c.diags
+	}
+
+	c.meta, c.diags = configure(ctx, config, envAccessToken, terraformVersion)
+	c.config = &config
+	c.envAccessToken = envAccessToken
+
+	return c.meta, c.diags
+}
+
+// configure creates the client for artifactory, will prefer token auth over basic auth if both set
+func configure(ctx context.Context, config providerConfiguration, envAccessToken, terraformVersion string) (util.ProviderMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.url == "" {
+		diags.AddError(
+			"Missing URL Configuration",
+			"While configuring the provider, the url was not found in "+
+				"the JFROG_URL/ARTIFACTORY_URL environment variable or provider "+
+				"configuration block url attribute.",
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	restyClient, err := client.Build(config.url, productId)
+	if err != nil {
+		diags.AddError(
+			"Error creating Resty client",
+			err.Error(),
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	accessToken := envAccessToken
+
+	oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, config.oidcProviderName)
+	if err != nil {
+		diags.AddError(
+			"Failed OIDC ID token exchange",
+			err.Error(),
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	// use token from OIDC provider, which should take precedence over
+	// environment variable data, if found.
+	if oidcAccessToken != "" {
+		accessToken = oidcAccessToken
+	}
+
+	// Check configuration data, which should take precedence over
+	// environment variable data, if found.
+	if config.accessToken != "" {
+		accessToken = config.accessToken
+	}
+
+	if config.apiKey == "" && accessToken == "" {
+		diags.AddError(
+			"Missing JFrog API key or Access Token",
+			"While configuring the provider, the API key or Access Token was not found in "+
+				"the environment variables or provider configuration attributes.",
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	restyClient, err = client.AddAuth(restyClient, config.apiKey, accessToken)
+	if err != nil {
+		diags.AddError(
+			"Error adding Auth to Resty client",
+			err.Error(),
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	if config.checkLicense {
+		if err := util.CheckArtifactoryLicense(restyClient, "Enterprise", "Commercial", "Edge"); err != nil {
+			diags.AddError(
+				"Error checking Artifactory license",
+				err.Error(),
+			)
+			return util.ProviderMetadata{}, diags
+		}
+	}
+
+	version, err := util.GetArtifactoryVersion(restyClient)
+	if err != nil {
+		diags.AddError(
+			"Error getting Artifactory version",
+			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
+		)
+		return util.ProviderMetadata{}, diags
+	}
+
+	featureUsage := fmt.Sprintf("Terraform/%s", terraformVersion)
+	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
+
+	return util.ProviderMetadata{
+		Client:             restyClient,
+		ProductId:          productId,
+		ArtifactoryVersion: version,
+	}, diags
+}
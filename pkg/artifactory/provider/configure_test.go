@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var providerConfigType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"url":                tftypes.String,
+		"access_token":       tftypes.String,
+		"api_key":            tftypes.String,
+		"oidc_provider_name": tftypes.String,
+		"check_license":      tftypes.Bool,
+	},
+}
+
+// mkArtifactoryServer returns a fake Artifactory server, which counts the version requests made once per configure.
+func mkArtifactoryServer(t *testing.T, versionRequests *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/artifactory/api/system/version" {
+			atomic.AddInt32(versionRequests, 1)
+			_, _ = w.Write([]byte(`{"version":"7.84.0"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func configureMuxServer(t *testing.T, server tfprotov6.ProviderServer, url string) {
+	config, err := tfprotov6.NewDynamicValue(providerConfigType, tftypes.NewValue(providerConfigType, map[string]tftypes.Value{
+		"url":                tftypes.NewValue(tftypes.String, url),
+		"access_token":       tftypes.NewValue(tftypes.String, "token"),
+		"api_key":            tftypes.NewValue(tftypes.String, nil),
+		"oidc_provider_name": tftypes.NewValue(tftypes.String, nil),
+		"check_license":      tftypes.NewValue(tftypes.Bool, false),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create provider config: %s", err)
+	}
+
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.8.0",
+		Config:           &config,
+	})
+	if err != nil {
+		t.Fatalf("failed to configure provider: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error configuring provider: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func TestProtoV6ProviderServerFactory_configure_once(t *testing.T) {
+	t.Setenv("JFROG_ACCESS_TOKEN", "")
+	t.Setenv("ARTIFACTORY_ACCESS_TOKEN", "")
+
+	var versionRequests int32
+	server := mkArtifactoryServer(t, &versionRequests)
+
+	c := &configurer{}
+	sdkV2Provider := sdkV2(c)
+	providerServerFactory, err := protoV6ProviderServerFactory(context.Background(), sdkV2Provider, framework(c)())
+	if err != nil {
+		t.Fatalf("failed to create provider server: %s", err)
+	}
+
+	configureMuxServer(t, providerServerFactory(), server.URL)
+
+	if atomic.LoadInt32(&versionRequests) != 1 {
+		t.Fatalf("expected provider to be configured once, got %d", atomic.LoadInt32(&versionRequests))
+	}
+
+	sdkV2Meta, ok := sdkV2Provider.Meta().(util.ProviderMetadata)
+	if !ok {
+		t.Fatalf("expected SDKv2 provider meta to be util.ProviderMetadata, got %T", sdkV2Provider.Meta())
+	}
+	if sdkV2Meta.Client == nil || sdkV2Meta.Client != c.meta.Client {
+		t.Fatalf("expected SDKv2 and Framework providers to share the same client")
+	}
+	if sdkV2Meta.ArtifactoryVersion != "7.84.0" || c.meta.ArtifactoryVersion != sdkV2Meta.ArtifactoryVersion {
+		t.Fatalf("expected Artifactory version '7.84.0', got '%s' and '%s'", sdkV2Meta.ArtifactoryVersion, c.meta.ArtifactoryVersion)
+	}
+}
+
+func TestProtoV6ProviderServerFactory_configure_changed_config(t *testing.T) {
+	t.Setenv("JFROG_ACCESS_TOKEN", "")
+	t.Setenv("ARTIFACTORY_ACCESS_TOKEN", "")
+
+	var versionRequests, otherVersionRequests int32
+	server := mkArtifactoryServer(t, &versionRequests)
+	otherServer := mkArtifactoryServer(t, &otherVersionRequests)
+
+	c := &configurer{}
+	sdkV2Provider := sdkV2(c)
+	providerServerFactory, err := protoV6ProviderServerFactory(context.Background(), sdkV2Provider, framework(c)())
+	if err != nil {
+		t.Fatalf("failed to create provider server: %s", err)
+	}
+	providerServer := providerServerFactory()
+
+	configureMuxServer(t, providerServer, server.URL)
+	client := c.meta.Client
+
+	configureMuxServer(t, providerServer, server.URL)
+	if atomic.LoadInt32(&versionRequests) != 1 || c.meta.Client != client {
+		t.Fatalf("expected unchanged config to reuse the provider metadata, got %d configures", atomic.LoadInt32(&versionRequests))
+	}
+
+	configureMuxServer(t, providerServer, otherServer.URL)
+	if atomic.LoadInt32(&otherVersionRequests) != 1 {
+		t.Fatalf("expected changed config to configure the provider once, got %d", atomic.LoadInt32(&otherVersionRequests))
+	}
+	if c.meta.Client == client {
+		t.Fatalf("expected changed config to create a new client")
+	}
+	if sdkV2Provider.Meta().(util.ProviderMetadata).Client != c.meta.Client {
+		t.Fatalf("expected SDKv2 and Framework providers to share the same client")
+	}
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -12,20 +11,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	datasource_artifact "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/datasource/artifact"
+	datasource_configuration "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/datasource/configuration"
 	datasource_repository "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/datasource/repository"
+	datasource_security "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/datasource/security"
 	rs "github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/security"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/user"
-	"github.com/jfrog/terraform-provider-shared/client"
-	"github.com/jfrog/terraform-provider-shared/util"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 )
 
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &ArtifactoryProvider{}
 
-type ArtifactoryProvider struct{}
+type ArtifactoryProvider struct {
+	configurer *configurer
+}
 
 // ArtifactoryProviderModel describes the provider data model.
 type ArtifactoryProviderModel struct {
@@ -83,10 +84,6 @@ func (p *ArtifactoryProvider) Schema(ctx context.Context, req provider.SchemaReq
 }
 
 func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	// Check environment variables, first available OS variable will be assigned to the var
-	url := util.CheckEnvVars([]string{"JFROG_URL", "ARTIFACTORY_URL"}, "")
-	accessToken := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN", "ARTIFACTORY_ACCESS_TOKEN"}, "")
-
 	var config ArtifactoryProviderModel
 
 	// Read configuration data into model
@@ -95,97 +92,22 @@ func (p *ArtifactoryProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	if config.Url.ValueString() != "" {
-		url = config.Url.ValueString()
-	}
-
-	if url == "" {
-		resp.Diagnostics.AddError(
-			"Missing URL Configuration",
-			"While configuring the provider, the url was not found in "+
-				"the JFROG_URL/ARTIFACTORY_URL environment variable or provider "+
-				"configuration block url attribute.",
-		)
-		return
-	}
-
-	restyClient, err := client.Build(url, productId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Resty client",
-			err.Error(),
-		)
-		return
-	}
-
-	oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, config.OIDCProviderName.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed OIDC ID token exchange",
-			err.Error(),
-		)
-		return
-	}
-
-	// use token from OIDC provider, which should take precedence over
-	// environment variable data, if found.
-	if oidcAccessToken != "" {
-		accessToken = oidcAccessToken
-	}
-
-	// Check configuration data, which should take precedence over
-	// environment variable data, if found.
-	if config.AccessToken.ValueString() != "" {
-		accessToken = config.AccessToken.ValueString()
-	}
-
-	apiKey := config.ApiKey.ValueString()
-
-	if apiKey == "" && accessToken == "" {
-		resp.Diagnostics.AddError(
-			"Missing JFrog API key or Access Token",
-			"While configuring the provider, the API key or Access Token was not found in "+
-				"the environment variables or provider configuration attributes.",
-		)
-		return
-	}
-
-	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error adding Auth to Resty client",
-			err.Error(),
-		)
-	}
-
-	if config.CheckLicense.IsNull() || config.CheckLicense.ValueBool() {
-		if err := util.CheckArtifactoryLicense(restyClient, "Enterprise", "Commercial", "Edge"); err != nil {
-			resp.Diagnostics.AddError(
-				"Error checking Artifactory license",
-				err.Error(),
-			)
-			return
-		}
-	}
-
-	version, err := util.GetArtifactoryVersion(restyClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting Artifactory version",
-			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
-		)
+	meta, diags := p.configurer.configure(
+		ctx,
+		providerConfiguration{
+			url:              config.Url.ValueString(),
+			accessToken:      config.AccessToken.ValueString(),
+			apiKey:           config.ApiKey.ValueString(),
+			oidcProviderName: config.OIDCProviderName.ValueString(),
+			checkLicense:     config.CheckLicense.IsNull() || config.CheckLicense.ValueBool(),
+		},
+		req.TerraformVersion,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
-
-	meta := util.ProviderMetadata{
-		Client:             restyClient,
-		ProductId:          productId,
-		ArtifactoryVersion: version,
-	}
-
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
 }
@@ -221,12 +143,22 @@ func (p *ArtifactoryProvider) Resources(ctx context.Context) []func() resource.R
 func (p *ArtifactoryProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasource_repository.NewRepositoriesDataSource,
+		datasource_repository.NewRemoteRepositoryStatusDataSource,
 		datasource_artifact.NewFileListDataSource,
+		datasource_configuration.NewConfigurationDataSource,
+		datasource_security.NewKeyPairDataSource,
+		datasource_security.NewEncryptedPasswordDataSource,
 	}
 }
 
 func Framework() func() provider.Provider {
+	return framework(&configurer{})
+}
+
+func framework(c *configurer) func() provider.Provider {
 	return func() provider.Provider {
-		return &ArtifactoryProvider{}
+		return &ArtifactoryProvider{
+			configurer: c,
+		}
 	}
 }
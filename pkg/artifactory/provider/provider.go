@@ -1,4 +1,47 @@
 package provider
 
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
 var Version = "10.0.0" // needs to be exported so make file can update this
 var productId = "terraform-provider-artifactory/" + Version
+
+// ProtoV6ProviderServerFactory returns the protocol v6 provider server, which muxes the SDKv2 (upgraded to protocol v6)
+// and Framework providers. Both providers share the same configurer, so they are configured through a single path
+// and use the same ProviderMetadata.
+func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	c := &configurer{}
+	return protoV6ProviderServerFactory(ctx, sdkV2(c), framework(c)())
+}
+
+func protoV6ProviderServerFactory(ctx context.Context, sdkV2Provider *schema.Provider, frameworkProvider provider.Provider) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(
+		ctx,
+		sdkV2Provider.GRPCProvider, // terraform-plugin-sdk provider
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(frameworkProvider), // terraform-plugin-framework provider
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -2,19 +2,21 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/jfrog/terraform-provider-shared/client"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/validator"
 )
 
 // SdkV2 Artifactory provider that supports configuration via Access Token
 // Supported resources are repos, users, groups, replications, and permissions
 func SdkV2() *schema.Provider {
+	return sdkV2(&configurer{})
+}
+
+func sdkV2(c *configurer) *schema.Provider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"url": {
@@ -57,84 +59,46 @@ func SdkV2() *schema.Provider {
 	}
 
 	p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		ds := diag.Diagnostics{}
-		meta, d := providerConfigure(ctx, data, p.TerraformVersion)
-		if d != nil {
-			ds = append(ds, d...)
+		meta, diags := c.configure(ctx, unpackProviderConfiguration(data), p.TerraformVersion)
+		if diags.HasError() {
+			return nil, toSdkV2Diagnostics(diags)
 		}
-		return meta, ds
+		return meta, toSdkV2Diagnostics(diags)
 	}
 
 	return p
 }
 
-// Creates the client for artifactory, will prefer token auth over basic auth if both set
-func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
-	// Check environment variables, first available OS variable will be assigned to the var
-	url := util.CheckEnvVars([]string{"JFROG_URL", "ARTIFACTORY_URL"}, "")
-	accessToken := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN", "ARTIFACTORY_ACCESS_TOKEN"}, "")
-
-	if v, ok := d.GetOk("url"); ok {
-		url = v.(string)
-	}
-	if url == "" {
-		return nil, diag.Errorf("missing URL Configuration")
-	}
-
-	restyClient, err := client.Build(url, productId)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	if v, ok := d.GetOk("oidc_provider_name"); ok {
-		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, v.(string))
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
-
-		if oidcAccessToken != "" {
-			accessToken = oidcAccessToken
-		}
-	}
-
-	if v, ok := d.GetOk("access_token"); ok && v != "" {
-		accessToken = v.(string)
-	}
-
-	apiKey := d.Get("api_key").(string)
-
-	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
+func unpackProviderConfiguration(d *schema.ResourceData) providerConfiguration {
 	// Due to migration from SDK v2 to plugin framework, we have to remove defaults from the provider configuration.
 	// https://discuss.hashicorp.com/t/muxing-upgraded-tfsdk-and-framework-provider-with-default-provider-configuration/43945
 	checkLicense := true
 	if v, ok := d.GetOkExists("check_license"); ok {
 		checkLicense = v.(bool)
 	}
-	if checkLicense {
-		licenseErr := util.CheckArtifactoryLicense(restyClient, "Enterprise", "Commercial", "Edge")
-		if licenseErr != nil {
-			return nil, diag.FromErr(licenseErr)
-		}
-	}
 
-	version, err := util.GetArtifactoryVersion(restyClient)
-	if err != nil {
-		return nil, diag.Diagnostics{{
-			Severity: diag.Error,
-			Summary:  "Error getting Artifactory version",
-			Detail:   fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
-		}}
+	return providerConfiguration{
+		url:              d.Get("url").(string),
+		accessToken:      d.Get("access_token").(string),
+		apiKey:           d.Get("api_key").(string),
+		oidcProviderName: d.Get("oidc_provider_name").(string),
+		checkLicense:     checkLicense,
 	}
+}
 
-	featureUsage := fmt.Sprintf("Terraform/%s", terraformVersion)
-	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
-
-	return util.ProviderMetadata{
-		Client:             restyClient,
-		ArtifactoryVersion: version,
-	}, nil
+// toSdkV2Diagnostics converts the diagnostics from the shared configuration path into SDKv2 diagnostics.
+func toSdkV2Diagnostics(diags fwdiag.Diagnostics) diag.Diagnostics {
+	var ds diag.Diagnostics
+	for _, d := range diags {
+		severity := diag.Warning
+		if d.Severity() == fwdiag.SeverityError {
+			severity = diag.Error
+		}
+		ds = append(ds, diag.Diagnostic{
+			Severity: severity,
+			Summary:  d.Summary(),
+			Detail:   d.Detail(),
+		})
+	}
+	return ds
 }
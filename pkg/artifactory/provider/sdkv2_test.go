@@ -1,8 +1,10 @@
 package provider_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/provider"
 )
 
@@ -15,3 +17,17 @@ func TestProvider(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	var _ = provider.SdkV2()
 }
+
+func TestProvider_configure_missing_url(t *testing.T) {
+	t.Setenv("JFROG_URL", "")
+	t.Setenv("ARTIFACTORY_URL", "")
+
+	diags := provider.SdkV2().Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if !diags.HasError() {
+		t.Fatalf("expected error, got none")
+	}
+
+	if diags[0].Summary != "Missing URL Configuration" {
+		t.Fatalf("expected 'Missing URL Configuration' error, got: %s", diags[0].Summary)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/packer"
+	"github.com/jfrog/terraform-provider-shared/predicate"
 	"github.com/jfrog/terraform-provider-shared/unpacker"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/jfrog/terraform-provider-shared/validator"
@@ -24,7 +25,8 @@ type RepositoryRemoteBaseParams struct {
 	PackageType                       string                             `json:"packageType,omitempty"`
 	Url                               string                             `json:"url"`
 	Username                          string                             `json:"username"`
-	Password                          string                             `json:"password,omitempty"` // must have 'omitempty' to avoid sending an empty string on update, if attribute is ignored by the provider.
+	Password                          string                             `json:"password,omitempty"`    // must have 'omitempty' to avoid sending an empty string on update, if attribute is ignored by the provider.
+	AccessToken                       *string                            `json:"accessToken,omitempty"` // pointer to send an empty string on update only when the attribute is removed.
 	Proxy                             string                             `json:"proxy"`
 	DisableProxy                      bool                               `json:"disableProxy"`
 	Description                       string                             `json:"description"`
@@ -120,9 +122,19 @@ var BaseRemoteRepoSchema = func(isResource bool) map[string]*schema.Schema {
 				Optional: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"access_token"},
+			},
+			"access_token": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validator.StringIsNotEmpty,
+				ConflictsWith:    []string{"username", "password"},
+				Description: "Access token used as bearer token to authenticate against the remote URL, e.g. an upstream Artifactory " +
+					"with basic authentication disabled. Can't be set together with `username` and `password`.",
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -523,6 +535,10 @@ func UnpackBaseRemoteRepo(s *schema.ResourceData, packageType string) Repository
 			},
 		}
 	}
+	// Send an empty token when the attribute is removed, otherwise the previous token stays on the repository
+	if accessToken := d.GetString("access_token", false); accessToken != "" || s.HasChange("access_token") {
+		repo.AccessToken = &accessToken
+	}
 	return repo
 }
 
@@ -556,6 +572,19 @@ var resourceV2 = &schema.Resource{
 	Schema: baseRemoteRepoSchemaV2,
 }
 
+// noPasswordAndAccessToken excludes the `access_token` attribute from packing, the same way as `password`,
+// since the API never returns the token in plain text, which would otherwise cause a state drift.
+var noPasswordAndAccessToken = predicate.Ignore("class", "rclass", "password", "access_token")
+
+func defaultPacker(skeema map[string]*schema.Schema) packer.PackFunc {
+	return packer.Universal(
+		predicate.All(
+			predicate.SchemaHasKey(skeema),
+			noPasswordAndAccessToken,
+		),
+	)
+}
+
 func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor) *schema.Resource {
 	var reader = repository.MkRepoRead(packer, constructor)
 	return &schema.Resource{
@@ -613,6 +642,27 @@ func verifyExternalDependenciesDockerAndHelm(_ context.Context, diff *schema.Res
 	return nil
 }
 
+// verifyCustomVcsGitProvider verifies `vcs_git_download_url` is set if, and only if, `vcs_git_provider` is set to `CUSTOM`.
+func verifyCustomVcsGitProvider(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Skip the verification if any value is only known after apply
+	if !diff.NewValueKnown("vcs_git_provider") || !diff.NewValueKnown("vcs_git_download_url") {
+		return nil
+	}
+
+	provider := diff.Get("vcs_git_provider").(string)
+	_, hasDownloadUrl := diff.GetOk("vcs_git_download_url")
+
+	if provider == "CUSTOM" && !hasDownloadUrl {
+		return fmt.Errorf("`vcs_git_download_url` must be set if `vcs_git_provider` is set to `CUSTOM`")
+	}
+
+	if provider != "CUSTOM" && hasDownloadUrl {
+		return fmt.Errorf("`vcs_git_download_url` can only be set if `vcs_git_provider` is set to `CUSTOM`")
+	}
+
+	return nil
+}
+
 func ResourceStateUpgradeV1(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
 	if rawState["package_type"] != "generic" {
 		delete(rawState, "propagate_query_params")
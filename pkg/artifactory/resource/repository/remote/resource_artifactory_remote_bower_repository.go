@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -62,5 +61,5 @@ func ResourceArtifactoryRemoteBowerRepository() *schema.Resource {
 
 	bowerSchema := BowerRemoteSchema(true)
 
-	return mkResourceSchema(bowerSchema, packer.Default(bowerSchema), unpackBowerRemoteRepo, constructor)
+	return mkResourceSchema(bowerSchema, defaultPacker(bowerSchema), unpackBowerRemoteRepo, constructor)
 }
@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -75,5 +74,5 @@ func ResourceArtifactoryRemoteCargoRepository() *schema.Resource {
 
 	cargoSchema := CargoRemoteSchema(true)
 
-	return mkResourceSchema(cargoSchema, packer.Default(cargoSchema), unpackCargoRemoteRepo, constructor)
+	return mkResourceSchema(cargoSchema, defaultPacker(cargoSchema), unpackCargoRemoteRepo, constructor)
 }
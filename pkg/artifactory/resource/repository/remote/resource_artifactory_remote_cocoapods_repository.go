@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -56,5 +55,5 @@ func ResourceArtifactoryRemoteCocoapodsRepository() *schema.Resource {
 
 	cocoapodsSchema := CocoapodsRemoteSchema(true)
 
-	return mkResourceSchema(cocoapodsSchema, packer.Default(cocoapodsSchema), unpackCocoapodsRemoteRepo, constructor)
+	return mkResourceSchema(cocoapodsSchema, defaultPacker(cocoapodsSchema), unpackCocoapodsRemoteRepo, constructor)
 }
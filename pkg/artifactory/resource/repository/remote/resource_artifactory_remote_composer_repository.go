@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -61,5 +60,5 @@ func ResourceArtifactoryRemoteComposerRepository() *schema.Resource {
 
 	composerSchema := ComposerRemoteSchema(true)
 
-	return mkResourceSchema(composerSchema, packer.Default(composerSchema), unpackComposerRemoteRepo, constructor)
+	return mkResourceSchema(composerSchema, defaultPacker(composerSchema), unpackComposerRemoteRepo, constructor)
 }
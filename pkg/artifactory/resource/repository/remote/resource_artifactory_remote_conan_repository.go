@@ -3,7 +3,6 @@ package remote
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -55,7 +54,7 @@ func ResourceArtifactoryRemoteConanRepository() *schema.Resource {
 
 	return mkResourceSchema(
 		conanSchema,
-		packer.Default(conanSchema),
+		defaultPacker(conanSchema),
 		unpackConanRepo,
 		constructor,
 	)
@@ -90,7 +90,7 @@ func ResourceArtifactoryRemoteDockerRepository() *schema.Resource {
 	dockerRemoteRepoPacker := packer.Universal(
 		predicate.All(
 			predicate.SchemaHasKey(dockerSchema),
-			predicate.NoPassword,
+			noPasswordAndAccessToken,
 		),
 	)
 
@@ -3,7 +3,6 @@ package remote
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -59,7 +58,7 @@ func ResourceArtifactoryRemoteGenericRepository() *schema.Resource {
 
 	genericSchema := GenericRemoteSchema(true)
 
-	return mkResourceSchema(genericSchema, packer.Default(genericSchema), unpackGenericRemoteRepo, constructor)
+	return mkResourceSchema(genericSchema, defaultPacker(genericSchema), unpackGenericRemoteRepo, constructor)
 }
 
 var BasicRepoSchema = func(packageType string, isResource bool) map[string]*schema.Schema {
@@ -87,5 +86,5 @@ func ResourceArtifactoryRemoteBasicRepository(packageType string) *schema.Resour
 
 	mergedRemoteRepoSchema := BasicRepoSchema(packageType, true)
 
-	return mkResourceSchema(mergedRemoteRepoSchema, packer.Default(mergedRemoteRepoSchema), unpack, constructor)
+	return mkResourceSchema(mergedRemoteRepoSchema, defaultPacker(mergedRemoteRepoSchema), unpack, constructor)
 }
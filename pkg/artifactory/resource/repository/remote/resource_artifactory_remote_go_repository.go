@@ -1,10 +1,10 @@
 package remote
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -12,7 +12,9 @@ const GoPackageType = "go"
 
 type GoRemoteRepo struct {
 	RepositoryRemoteBaseParams
-	VcsGitProvider string `json:"vcsGitProvider"`
+	VcsGitProvider    string   `json:"vcsGitProvider"`
+	VcsGitDownloadUrl string   `json:"vcsGitDownloadUrl"`
+	ExcludePatterns   []string `hcl:"exclude_patterns" json:"goSumdbExcludePatterns"`
 }
 
 var GoRemoteSchema = func(isResource bool) map[string]*schema.Schema {
@@ -23,10 +25,17 @@ var GoRemoteSchema = func(isResource bool) map[string]*schema.Schema {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Default:          "ARTIFACTORY",
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"GITHUB", "ARTIFACTORY"}, false)),
-				Description:      `Artifactory supports proxying the following Git providers out-of-the-box: GitHub or a remote Artifactory instance. Default value is "ARTIFACTORY".`,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"GITHUB", "ARTIFACTORY", "CUSTOM"}, false)),
+				Description:      `Artifactory supports proxying the following Git providers out-of-the-box: GitHub or a remote Artifactory instance. Use "CUSTOM" together with 'vcs_git_download_url' for other providers. Default value is "ARTIFACTORY".`,
+			},
+			"vcs_git_download_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+				Description:      `The URL used as proxy for the Git provider. Must be set if 'vcs_git_provider' is set to 'CUSTOM', and can't be set otherwise.`,
 			},
 		},
+		repository.GoSumdbExcludePatternsSchema,
 		repository.RepoLayoutRefSchema(rclass, GoPackageType),
 	)
 }
@@ -38,7 +47,10 @@ func ResourceArtifactoryRemoteGoRepository() *schema.Resource {
 		repo := GoRemoteRepo{
 			RepositoryRemoteBaseParams: UnpackBaseRemoteRepo(s, GoPackageType),
 			VcsGitProvider:             d.GetString("vcs_git_provider", false),
+			VcsGitDownloadUrl:          d.GetString("vcs_git_download_url", false),
+			ExcludePatterns:            d.GetList("exclude_patterns"),
 		}
+
 		return repo, repo.Id(), nil
 	}
 
@@ -59,5 +71,11 @@ func ResourceArtifactoryRemoteGoRepository() *schema.Resource {
 
 	goSchema := GoRemoteSchema(true)
 
-	return mkResourceSchema(goSchema, packer.Default(goSchema), unpackGoRemoteRepo, constructor)
+	resource := mkResourceSchema(goSchema, defaultPacker(goSchema), unpackGoRemoteRepo, constructor)
+	resource.CustomizeDiff = customdiff.All(
+		resource.CustomizeDiff,
+		verifyCustomVcsGitProvider,
+	)
+
+	return resource
 }
@@ -77,7 +77,7 @@ func ResourceArtifactoryRemoteHelmRepository() *schema.Resource {
 	helmRemoteRepoPacker := packer.Universal(
 		predicate.All(
 			predicate.SchemaHasKey(helmSchema),
-			predicate.NoPassword,
+			noPasswordAndAccessToken,
 		),
 	)
 
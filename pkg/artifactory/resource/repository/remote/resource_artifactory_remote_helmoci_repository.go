@@ -79,7 +79,7 @@ func ResourceArtifactoryRemoteHelmOciRepository() *schema.Resource {
 	remoteRepoPacker := packer.Universal(
 		predicate.All(
 			predicate.SchemaHasKey(schema),
-			predicate.NoPassword,
+			noPasswordAndAccessToken,
 		),
 	)
 
@@ -3,7 +3,6 @@ package remote
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -53,5 +52,5 @@ func ResourceArtifactoryRemoteHuggingFaceRepository() *schema.Resource {
 
 	huggingFaceSchema := HuggingFaceSchema(true)
 
-	return mkResourceSchema(huggingFaceSchema, packer.Default(huggingFaceSchema), unpackRepo, constructor)
+	return mkResourceSchema(huggingFaceSchema, defaultPacker(huggingFaceSchema), unpackRepo, constructor)
 }
@@ -2,7 +2,6 @@ package remote
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jfrog/terraform-provider-shared/packer"
 )
 
 func ResourceArtifactoryRemoteJavaRepository(packageType string, suppressPom bool) *schema.Resource {
@@ -23,5 +22,5 @@ func ResourceArtifactoryRemoteJavaRepository(packageType string, suppressPom boo
 		}, nil
 	}
 
-	return mkResourceSchema(javaRemoteSchema, packer.Default(javaRemoteSchema), unpackJavaRemoteRepo, constructor)
+	return mkResourceSchema(javaRemoteSchema, defaultPacker(javaRemoteSchema), unpackJavaRemoteRepo, constructor)
 }
@@ -54,7 +54,7 @@ func ResourceArtifactoryRemoteMavenRepository() *schema.Resource {
 		}, nil
 	}
 
-	return mkResourceSchemaMaven(mavenRemoteSchema, packer.Default(mavenRemoteSchema), unpackMavenRemoteRepo, constructor)
+	return mkResourceSchemaMaven(mavenRemoteSchema, defaultPacker(mavenRemoteSchema), unpackMavenRemoteRepo, constructor)
 }
 
 var resourceMavenV1 = &schema.Resource{
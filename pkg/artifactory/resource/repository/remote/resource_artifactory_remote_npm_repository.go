@@ -3,7 +3,6 @@ package remote
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -51,5 +50,5 @@ func ResourceArtifactoryRemoteNpmRepository() *schema.Resource {
 
 	npmSchema := NpmRemoteSchema(true)
 
-	return mkResourceSchema(npmSchema, packer.Default(npmSchema), unpack, constructor)
+	return mkResourceSchema(npmSchema, defaultPacker(npmSchema), unpack, constructor)
 }
@@ -1,10 +1,11 @@
 package remote
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -19,29 +20,36 @@ type NugetRemoteRepo struct {
 
 const NugetPackageType = "nuget"
 
+// contextPathDiffSuppress ignores leading and trailing slashes in the NuGet context paths, since they are
+// relative to the repository URL, e.g. 'download' and '/download/' resolve to the same location.
+func contextPathDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.Trim(old, "/") == strings.Trim(new, "/")
+}
+
 var NugetRemoteSchema = func(isResource bool) map[string]*schema.Schema {
 	return utilsdk.MergeMaps(
 		BaseRemoteRepoSchema(isResource),
 		map[string]*schema.Schema{
 			"feed_context_path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "api/v2",
-				Description: `When proxying a remote NuGet repository, customize feed resource location using this attribute. Default value is 'api/v2'.`,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "api/v2",
+				DiffSuppressFunc: contextPathDiffSuppress,
+				Description:      `When proxying a remote NuGet repository, customize feed resource location using this attribute. Set to empty string when proxying third-party v3 only feeds (e.g. GitHub Packages, Azure Artifacts). Default value is 'api/v2'.`,
 			},
 			"download_context_path": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Default:          "api/v2/package",
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
-				Description:      `The context path prefix through which NuGet downloads are served. Default value is 'api/v2/package'.`,
+				DiffSuppressFunc: contextPathDiffSuppress,
+				Description:      `The context path prefix through which NuGet downloads are served. Set to empty string or to the download path of the feed (e.g. 'download' for GitHub Packages) when proxying third-party v3 feeds. Default value is 'api/v2/package'.`,
 			},
 			"v3_feed_url": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Default:          "https://api.nuget.org/v3/index.json",
 				ValidateDiagFunc: validation.ToDiagFunc(validation.Any(validation.IsURLWithHTTPorHTTPS, validation.StringIsEmpty)),
-				Description:      `The URL to the NuGet v3 feed. Default value is 'https://api.nuget.org/v3/index.json'.`,
+				Description:      `The URL to the NuGet v3 feed, i.e. the service index of the feed, e.g. 'https://nuget.pkg.github.com/OWNER/index.json' for GitHub Packages. Default value is 'https://api.nuget.org/v3/index.json'.`,
 			},
 			"force_nuget_authentication": {
 				Type:        schema.TypeBool,
@@ -92,5 +100,5 @@ func ResourceArtifactoryRemoteNugetRepository() *schema.Resource {
 
 	nugetSchema := NugetRemoteSchema(true)
 
-	return mkResourceSchema(nugetSchema, packer.Default(nugetSchema), unpackNugetRemoteRepo, constructor)
+	return mkResourceSchema(nugetSchema, defaultPacker(nugetSchema), unpackNugetRemoteRepo, constructor)
 }
@@ -79,7 +79,7 @@ func ResourceArtifactoryRemoteOciRepository() *schema.Resource {
 	ociRemoteRepoPacker := packer.Universal(
 		predicate.All(
 			predicate.SchemaHasKey(schema),
-			predicate.NoPassword,
+			noPasswordAndAccessToken,
 		),
 	)
 
@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -67,5 +66,5 @@ func ResourceArtifactoryRemotePypiRepository() *schema.Resource {
 
 	pypiSchema := PypiRemoteSchema(true)
 
-	return mkResourceSchema(pypiSchema, packer.Default(pypiSchema), unpackPypiRemoteRepo, constructor)
+	return mkResourceSchema(pypiSchema, defaultPacker(pypiSchema), unpackPypiRemoteRepo, constructor)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository/remote"
@@ -585,6 +586,66 @@ func TestAccRemoteGoRepository(t *testing.T) {
 	}))
 }
 
+func TestAccRemoteGoRepositoryWithCustomVcsAndExcludePatterns(t *testing.T) {
+	const packageType = "go"
+	resource.Test(mkNewRemoteTestCase(packageType, t, map[string]interface{}{
+		"url":                         "https://proxy.golang.org/",
+		"vcs_git_provider":            "CUSTOM",
+		"vcs_git_download_url":        "https://git.my-company.com",
+		"exclude_patterns":            []interface{}{"github.com/my-org/*", "git.my-company.com/*"},
+		"missed_cache_period_seconds": 1800,
+	}))
+}
+
+func TestAccRemoteGoRepository_invalid_custom_vcs(t *testing.T) {
+	testCases := []struct {
+		name        string
+		extraConfig string
+		errorRegex  string
+	}{
+		{
+			name:        "missing_download_url",
+			extraConfig: `vcs_git_provider = "CUSTOM"`,
+			errorRegex:  ".*`vcs_git_download_url` must be set if `vcs_git_provider` is set to `CUSTOM`.*",
+		},
+		{
+			name: "download_url_without_custom",
+			extraConfig: `vcs_git_provider     = "GITHUB"
+			vcs_git_download_url = "https://git.my-company.com"`,
+			errorRegex: ".*`vcs_git_download_url` can only be set if `vcs_git_provider` is set to `CUSTOM`.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fqrn, name := testutil.MkNames("tf-go-remote-", "artifactory_remote_go_repository")
+
+			config := util.ExecuteTemplate("TestAccRemoteGoRepository_invalid_custom_vcs", `
+				resource "artifactory_remote_go_repository" "{{ .name }}" {
+					key = "{{ .name }}"
+					url = "https://proxy.golang.org/"
+					{{ .extraConfig }}
+				}
+			`, map[string]string{
+				"name":        name,
+				"extraConfig": tc.extraConfig,
+			})
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { acctest.PreCheck(t) },
+				ProviderFactories: acctest.ProviderFactories,
+				CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile(tc.errorRegex),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccRemoteVcsRepository(t *testing.T) {
 	const packageType = "vcs"
 	resource.Test(mkNewRemoteTestCase(packageType, t, map[string]interface{}{
@@ -653,6 +714,98 @@ func TestAccRemoteNugetRepository(t *testing.T) {
 	}))
 }
 
+func TestAccRemoteNugetRepository_third_party_v3_feed(t *testing.T) {
+	const packageType = "nuget"
+	resource.Test(mkNewRemoteTestCase(packageType, t, map[string]interface{}{
+		"url":                         "https://nuget.pkg.github.com/jfrog",
+		"feed_context_path":           "",
+		"download_context_path":       "download",
+		"v3_feed_url":                 "https://nuget.pkg.github.com/jfrog/index.json",
+		"missed_cache_period_seconds": 1800,
+	}))
+}
+
+func TestNugetContextPathDiffSuppress(t *testing.T) {
+	nugetSchema := remote.NugetRemoteSchema(true)
+
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{old: "download", new: "download", suppress: true},
+		{old: "download", new: "/download/", suppress: true},
+		{old: "/download", new: "download/", suppress: true},
+		{old: "", new: "/", suppress: true},
+		{old: "api/v2/package", new: "download", suppress: false},
+		{old: "api/v2", new: "", suppress: false},
+	}
+
+	for _, attr := range []string{"feed_context_path", "download_context_path"} {
+		for _, tc := range testCases {
+			suppress := nugetSchema[attr].DiffSuppressFunc(attr, tc.old, tc.new, nil)
+			if suppress != tc.suppress {
+				t.Errorf("%s: expected diff between '%s' and '%s' to be suppressed: %t, got %t", attr, tc.old, tc.new, tc.suppress, suppress)
+			}
+		}
+	}
+}
+
+func TestAccRemoteNugetRepository_context_path_slashes(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-nuget-remote-", "artifactory_remote_nuget_repository")
+
+	const template = `
+		resource "artifactory_remote_nuget_repository" "{{ .name }}" {
+			key                   = "{{ .name }}"
+			url                   = "https://nuget.pkg.github.com/jfrog"
+			v3_feed_url           = "https://nuget.pkg.github.com/jfrog/index.json"
+			feed_context_path     = ""
+			download_context_path = "{{ .downloadContextPath }}"
+		}
+	`
+
+	mkConfig := func(downloadContextPath string) string {
+		return util.ExecuteTemplate("TestAccRemoteNugetRepository_context_path_slashes", template, map[string]string{
+			"name":                name,
+			"downloadContextPath": downloadContextPath,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				// post apply plan must be empty whether Artifactory keeps or strips the slashes
+				Config: mkConfig("/download/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "feed_context_path", ""),
+					resource.TestMatchResourceAttr(fqrn, "download_context_path", regexp.MustCompile(`^/?download/?$`)),
+				),
+			},
+			{
+				Config: mkConfig("download"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: mkConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "download_context_path", ""),
+				),
+			},
+			{
+				Config:   mkConfig(""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccRemoteTerraformRepository(t *testing.T) {
 	const packageType = "terraform"
 	resource.Test(mkNewRemoteTestCase(packageType, t, map[string]interface{}{
@@ -1575,6 +1728,100 @@ func TestAccRemoteDisableDefaultProxyConflictAttrGH739(t *testing.T) {
 	})
 }
 
+func TestAccRemoteRepository_access_token(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-generic-remote-", "artifactory_remote_generic_repository")
+
+	const template = `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key          = "{{ .name }}"
+			url          = "https://tempurl.org/artifactory/api/generic/generic-local"
+			access_token = "{{ .accessToken }}"
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccRemoteRepository_access_token", template, map[string]string{
+		"name":        name,
+		"accessToken": "token1",
+	})
+	updatedConfig := util.ExecuteTemplate("TestAccRemoteRepository_access_token", template, map[string]string{
+		"name":        name,
+		"accessToken": "token2",
+	})
+	noTokenConfig := util.ExecuteTemplate("TestAccRemoteRepository_access_token", `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key      = "{{ .name }}"
+			url      = "https://tempurl.org/artifactory/api/generic/generic-local"
+			username = "user"
+			password = "password"
+		}
+	`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "access_token", "token1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "access_token", "token2"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_token"},
+			},
+			{
+				Config: noTokenConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "username", "user"),
+					resource.TestCheckResourceAttr(fqrn, "access_token", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRemoteRepository_access_token_conflicts_with_password(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-generic-remote-", "artifactory_remote_generic_repository")
+
+	config := util.ExecuteTemplate("TestAccRemoteRepository_access_token", `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key          = "{{ .name }}"
+			url          = "https://tempurl.org/artifactory/api/generic/generic-local"
+			password     = "password"
+			access_token = "token"
+		}
+	`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*"access_token": conflicts with password.*`),
+			},
+		},
+	})
+}
+
 func TestAccRemoteRepositoryWithProjectAttributesGH318(t *testing.T) {
 	projectKey := fmt.Sprintf("t%d", testutil.RandomInt())
 	projectEnv := testutil.RandSelect("DEV", "PROD").(string)
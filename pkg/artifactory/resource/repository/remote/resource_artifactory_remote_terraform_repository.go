@@ -4,7 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -63,5 +62,5 @@ func ResourceArtifactoryRemoteTerraformRepository() *schema.Resource {
 
 	terraformSchema := TerraformRemoteSchema(true)
 
-	return mkResourceSchema(terraformSchema, packer.Default(terraformSchema), unpackTerraformRemoteRepo, constructor)
+	return mkResourceSchema(terraformSchema, defaultPacker(terraformSchema), unpackTerraformRemoteRepo, constructor)
 }
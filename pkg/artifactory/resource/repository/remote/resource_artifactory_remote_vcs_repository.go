@@ -3,7 +3,6 @@ package remote
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jfrog/terraform-provider-artifactory/v11/pkg/artifactory/resource/repository"
-	"github.com/jfrog/terraform-provider-shared/packer"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
 
@@ -61,5 +60,5 @@ func ResourceArtifactoryRemoteVcsRepository() *schema.Resource {
 
 	vcsSchema := VcsRemoteSchema(true)
 
-	return mkResourceSchema(vcsSchema, packer.Default(vcsSchema), UnpackVcsRemoteRepo, constructor)
+	return mkResourceSchema(vcsSchema, defaultPacker(vcsSchema), UnpackVcsRemoteRepo, constructor)
 }
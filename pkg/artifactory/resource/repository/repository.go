@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"golang.org/x/exp/slices"
@@ -111,6 +112,19 @@ var CompressionFormats = map[string]*schema.Schema{
 	},
 }
 
+var GoSumdbExcludePatternsSchema = map[string]*schema.Schema{
+	"exclude_patterns": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		Description: "List of Go module path patterns (GONOSUMDB/GOPRIVATE syntax, e.g. `github.com/my-org/*`) that bypass " +
+			"the checksum database (sum.golang.org) verification. Use it for private modules.",
+	},
+}
+
 type ContentSynchronisation struct {
 	Enabled    bool                             `json:"enabled"`
 	Statistics ContentSynchronisationStatistics `json:"statistics"`
@@ -28,15 +28,16 @@ var GoVirtualSchema = utilsdk.MergeMaps(BaseVirtualRepoSchema, map[string]*schem
 		Description: "An allow list of Ant-style path patterns that determine which remote VCS roots Artifactory will " +
 			"follow to download remote modules from, when presented with 'go-import' meta tags in the remote repository response.",
 	},
-}, repository.RepoLayoutRefSchema(Rclass, GoPackageType))
+}, repository.GoSumdbExcludePatternsSchema, repository.RepoLayoutRefSchema(Rclass, GoPackageType))
 
-func ResourceArtifactoryVirtualGoRepository() *schema.Resource {
-	type GoVirtualRepositoryParams struct {
-		RepositoryBaseParams
-		ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled,omitempty"`
-		ExternalDependenciesPatterns []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns,omitempty"`
-	}
+type GoVirtualRepositoryParams struct {
+	RepositoryBaseParams
+	ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled,omitempty"`
+	ExternalDependenciesPatterns []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns,omitempty"`
+	ExcludePatterns              []string `hcl:"exclude_patterns" json:"goSumdbExcludePatterns"`
+}
 
+func ResourceArtifactoryVirtualGoRepository() *schema.Resource {
 	var unpackGoVirtualRepository = func(s *schema.ResourceData) (interface{}, string, error) {
 		d := &utilsdk.ResourceData{ResourceData: s}
 
@@ -44,6 +45,7 @@ func ResourceArtifactoryVirtualGoRepository() *schema.Resource {
 			RepositoryBaseParams:         UnpackBaseVirtRepo(s, GoPackageType),
 			ExternalDependenciesPatterns: d.GetList("external_dependencies_patterns"),
 			ExternalDependenciesEnabled:  d.GetBool("external_dependencies_enabled", false),
+			ExcludePatterns:              d.GetList("exclude_patterns"),
 		}
 		repo.PackageType = "go"
 		return &repo, repo.Key, nil
@@ -137,6 +137,9 @@ func TestAccVirtualGoRepository_basic(t *testing.T) {
 			"**/github.com/**",
 			"**/go.googlesource.com/**"
 		  ]
+		  exclude_patterns = [
+			"github.com/my-org/*",
+		  ]
 		}
 	`, name, name)
 
@@ -156,6 +159,8 @@ func TestAccVirtualGoRepository_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.0", "**/github.com/**"),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.1", "**/go.googlesource.com/**"),
 					resource.TestCheckResourceAttr(fqrn, "external_dependencies_patterns.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "exclude_patterns.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "exclude_patterns.0", "github.com/my-org/*"),
 					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string {
 						r, _ := repository.GetDefaultRepoLayoutRef(virtual.Rclass, packageType)()
 						return r.(string)